@@ -0,0 +1,99 @@
+// Package clipboard copies text to the system clipboard.
+//
+// It shells out to the platform's clipboard tool rather than linking
+// against native APIs: pbcopy on macOS, clip.exe on Windows, and wl-copy,
+// xclip or xsel on Linux (clip.exe under WSL), so it stays free of cgo.
+package clipboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout bounds how long a clipboard tool may take.
+const DefaultTimeout = 3 * time.Second
+
+// ErrUnavailable is returned when no supported clipboard tool is found.
+var ErrUnavailable = errors.New("no clipboard tool available")
+
+// backend is a clipboard tool that reads the text to copy from stdin.
+type backend struct {
+	name string
+	args []string
+}
+
+// lookPath is swapped out by tests that need to fake installed tools.
+var lookPath = exec.LookPath
+
+// Copy places text on the system clipboard.
+func Copy(ctx context.Context, text string) error {
+	b, err := detect(runtime.GOOS, os.Getenv)
+	if err != nil {
+		return err
+	}
+
+	return b.copy(ctx, text)
+}
+
+// Available reports whether a clipboard tool was found for this system.
+func Available() bool {
+	_, err := detect(runtime.GOOS, os.Getenv)
+	return err == nil
+}
+
+// detect picks the clipboard tool for goos, preferring the native tool of
+// the running display server on Linux.
+func detect(goos string, getenv func(string) string) (backend, error) {
+	var candidates []backend
+
+	switch goos {
+	case "darwin":
+		candidates = []backend{{name: "pbcopy"}}
+	case "windows":
+		candidates = []backend{{name: "clip.exe"}}
+	default:
+		if getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append(candidates, backend{name: "wl-copy"})
+		}
+		if getenv("DISPLAY") != "" {
+			candidates = append(candidates,
+				backend{name: "xclip", args: []string{"-selection", "clipboard"}},
+				backend{name: "xsel", args: []string{"--clipboard", "--input"}},
+			)
+		}
+		// WSL exposes the Windows clipboard through clip.exe.
+		candidates = append(candidates, backend{name: "clip.exe"})
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, b := range candidates {
+		if _, err := lookPath(b.name); err == nil {
+			return b, nil
+		}
+		names = append(names, b.name)
+	}
+
+	return backend{}, fmt.Errorf("%w: install one of %s", ErrUnavailable, strings.Join(names, ", "))
+}
+
+func (b backend) copy(ctx context.Context, text string) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	// Stdout and stderr stay unattached: xclip and wl-copy fork a child that
+	// keeps serving the selection, and would hold captured pipes open.
+	cmd := exec.CommandContext(ctx, b.name, b.args...) //nolint:gosec // name comes from the fixed backend list in detect
+	cmd.Stdin = strings.NewReader(text)
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to copy with %s: %w", b.name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package clipboard_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LeafLock-Security-Solutions/lazispace/internal/clipboard"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name      string
+		goos      string
+		env       map[string]string
+		installed []string
+		wantName  string
+		wantArgs  []string
+	}{
+		{"macOS", "darwin", nil, []string{"pbcopy"}, "pbcopy", nil},
+		{"windows", "windows", nil, []string{"clip.exe"}, "clip.exe", nil},
+		{
+			"wayland preferred over X11", "linux",
+			map[string]string{"WAYLAND_DISPLAY": "wayland-0", "DISPLAY": ":0"},
+			[]string{"wl-copy", "xclip"}, "wl-copy", nil,
+		},
+		{
+			"X11 falls back to xclip", "linux",
+			map[string]string{"WAYLAND_DISPLAY": "wayland-0", "DISPLAY": ":0"},
+			[]string{"xclip"}, "xclip", []string{"-selection", "clipboard"},
+		},
+		{
+			"xsel when xclip missing", "linux",
+			map[string]string{"DISPLAY": ":0"},
+			[]string{"xsel"}, "xsel", []string{"--clipboard", "--input"},
+		},
+		{"WSL without display", "linux", nil, []string{"clip.exe"}, "clip.exe", nil},
+		{"BSD with X11", "freebsd", map[string]string{"DISPLAY": ":0"}, []string{"xclip"}, "xclip", []string{"-selection", "clipboard"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clipboard.StubInstalled(t, tt.installed...)
+
+			name, args, err := clipboard.Detect(tt.goos, func(key string) string { return tt.env[key] })
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name != tt.wantName {
+				t.Errorf("expected %q, got %q", tt.wantName, name)
+			}
+			if !slices.Equal(args, tt.wantArgs) {
+				t.Errorf("expected args %q, got %q", tt.wantArgs, args)
+			}
+		})
+	}
+}
+
+func TestDetectUnavailable(t *testing.T) {
+	clipboard.StubInstalled(t)
+
+	_, _, err := clipboard.Detect("linux", func(key string) string {
+		if key == "DISPLAY" {
+			return ":0"
+		}
+		return ""
+	})
+	if !errors.Is(err, clipboard.ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "xclip, xsel, clip.exe") {
+		t.Errorf("expected install hint listing the tools, got %q", err)
+	}
+}
+
+// fakeTool installs an executable named name on an isolated PATH. It
+// writes its stdin to the returned file and exits with code.
+func fakeTool(t *testing.T, name string, code int) string {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("fake clipboard tools are shell scripts")
+	}
+
+	// Resolve cat before PATH is narrowed to the fake tool's directory.
+	cat, err := exec.LookPath("cat")
+	if err != nil {
+		t.Skipf("cat not available: %v", err)
+	}
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "copied.txt")
+	script := "#!/bin/sh\n" + cat + " > '" + out + "'\nexit " + strconv.Itoa(code) + "\n"
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0o700); err != nil {
+		t.Fatalf("failed to write fake %s: %v", name, err)
+	}
+
+	t.Setenv("PATH", dir)
+	t.Setenv("WAYLAND_DISPLAY", "")
+	t.Setenv("DISPLAY", ":0")
+
+	return out
+}
+
+func TestCopy(t *testing.T) {
+	if runtime.GOOS == "darwin" {
+		t.Skip("macOS always uses pbcopy")
+	}
+
+	t.Run("writes text to the tool", func(t *testing.T) {
+		out := fakeTool(t, "xclip", 0)
+
+		if !clipboard.Available() {
+			t.Fatal("expected clipboard to be available")
+		}
+
+		text := "cd '/home/dev/my project'"
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := clipboard.Copy(ctx, text); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		data, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatalf("expected tool to receive input: %v", err)
+		}
+		if string(data) != text {
+			t.Errorf("expected %q, got %q", text, string(data))
+		}
+	})
+
+	t.Run("tool failure reported", func(t *testing.T) {
+		fakeTool(t, "xclip", 1)
+
+		err := clipboard.Copy(context.Background(), "x")
+		if err == nil || !strings.Contains(err.Error(), "xclip") {
+			t.Errorf("expected error naming xclip, got %v", err)
+		}
+	})
+
+	t.Run("no tool installed", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+
+		if clipboard.Available() {
+			t.Error("expected clipboard to be unavailable")
+		}
+		if err := clipboard.Copy(context.Background(), "x"); !errors.Is(err, clipboard.ErrUnavailable) {
+			t.Errorf("expected ErrUnavailable, got %v", err)
+		}
+	})
+}
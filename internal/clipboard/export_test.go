@@ -0,0 +1,29 @@
+package clipboard
+
+import (
+	"os/exec"
+	"slices"
+	"testing"
+)
+
+// Detect exposes detect to the black-box tests and returns the tool name
+// and arguments of the chosen backend.
+func Detect(goos string, getenv func(string) string) (name string, args []string, err error) {
+	b, err := detect(goos, getenv)
+	return b.name, b.args, err
+}
+
+// StubInstalled makes only the given tools appear installed for the rest
+// of the test.
+func StubInstalled(tb testing.TB, tools ...string) {
+	tb.Helper()
+
+	orig := lookPath
+	lookPath = func(file string) (string, error) {
+		if slices.Contains(tools, file) {
+			return "/usr/bin/" + file, nil
+		}
+		return "", exec.ErrNotFound
+	}
+	tb.Cleanup(func() { lookPath = orig })
+}
@@ -0,0 +1,65 @@
+package format
+
+import (
+	"strconv"
+	"time"
+)
+
+// day is not defined by the time package.
+const day = 24 * time.Hour
+
+var durationUnits = []struct {
+	size   time.Duration
+	suffix string
+}{
+	{day, "d"},
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+}
+
+// Duration formats d using the default locale, e.g. "3h 5m".
+func Duration(d time.Duration) string {
+	return defaultFormatter.Duration(d)
+}
+
+// Duration formats d with its two most significant units, e.g. "2d 3h",
+// "3h 5m" or "45s". Sub-second durations render as milliseconds or, below
+// one millisecond, with one fractional digit ("0.3ms").
+func (f Formatter) Duration(d time.Duration) string {
+	// Work on the magnitude as uint64: negating math.MinInt64 overflows.
+	sign := ""
+	u := uint64(d)
+	if d < 0 {
+		sign = "-"
+		u = uint64(-(d + 1)) + 1
+	}
+
+	if u < uint64(time.Second) {
+		if u < uint64(time.Millisecond) && u > 0 {
+			return sign + f.decimal(float64(u)/float64(time.Millisecond)) + "ms"
+		}
+		return sign + strconv.FormatUint(u/uint64(time.Millisecond), 10) + "ms"
+	}
+
+	for i, unit := range durationUnits {
+		size := uint64(unit.size)
+		if u < size {
+			continue
+		}
+
+		n := u / size
+		out := strconv.FormatUint(n, 10) + unit.suffix
+
+		if i+1 < len(durationUnits) {
+			next := durationUnits[i+1]
+			if m := (u - n*size) / uint64(next.size); m > 0 {
+				out += " " + strconv.FormatUint(m, 10) + next.suffix
+			}
+		}
+
+		return sign + out
+	}
+
+	return ""
+}
@@ -0,0 +1,48 @@
+// Package format provides human-friendly rendering of durations, byte sizes
+// and timestamps so every command prints them the same way.
+package format
+
+import (
+	"strconv"
+	"strings"
+)
+
+// commaDecimalLanguages lists languages that use a comma as decimal separator.
+var commaDecimalLanguages = map[string]bool{
+	"cs": true, "da": true, "de": true, "es": true, "fi": true,
+	"fr": true, "it": true, "nb": true, "nl": true, "pl": true,
+	"pt": true, "ru": true, "sv": true, "tr": true, "uk": true,
+}
+
+// Formatter renders values for a specific locale. The zero value uses
+// English conventions.
+type Formatter struct {
+	commaDecimal bool
+}
+
+// defaultFormatter backs the package-level helpers.
+var defaultFormatter = New("en")
+
+// New returns a Formatter for a locale tag such as "en", "de-DE" or
+// "fr_FR.UTF-8". Unknown or empty locales fall back to English conventions.
+func New(locale string) Formatter {
+	return Formatter{commaDecimal: commaDecimalLanguages[language(locale)]}
+}
+
+// language extracts the lowercase language subtag from a locale tag.
+func language(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_."); i >= 0 {
+		locale = locale[:i]
+	}
+	return locale
+}
+
+// decimal renders v with one fractional digit, dropping a trailing ".0".
+func (f Formatter) decimal(v float64) string {
+	s := strings.TrimSuffix(strconv.FormatFloat(v, 'f', 1, 64), ".0")
+	if f.commaDecimal {
+		s = strings.Replace(s, ".", ",", 1)
+	}
+	return s
+}
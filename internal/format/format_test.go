@@ -0,0 +1,118 @@
+package format_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/LeafLock-Security-Solutions/lazispace/internal/format"
+)
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   int64
+		want string
+	}{
+		{"zero", 0, "0 B"},
+		{"bytes", 512, "512 B"},
+		{"kilobytes", 1500, "1.5 KB"},
+		{"whole megabytes", 3_000_000, "3 MB"},
+		{"gigabytes", 1_234_567_890, "1.2 GB"},
+		{"rounds up to next unit", 999_960, "1 MB"},
+		{"negative", -2048, "-2 KB"},
+		{"min int64", math.MinInt64, "-9.2 EB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := format.Bytes(tt.in); got != tt.want {
+				t.Errorf("Bytes(%d) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		in   time.Duration
+		want string
+	}{
+		{"zero", 0, "0ms"},
+		{"microseconds", 300 * time.Microsecond, "0.3ms"},
+		{"milliseconds", 120 * time.Millisecond, "120ms"},
+		{"seconds", 45 * time.Second, "45s"},
+		{"minutes and seconds", 65 * time.Second, "1m 5s"},
+		{"whole hours", 3 * time.Hour, "3h"},
+		{"hours and minutes", 3*time.Hour + 5*time.Minute + 9*time.Second, "3h 5m"},
+		{"days and hours", 51 * time.Hour, "2d 3h"},
+		{"negative", -90 * time.Second, "-1m 30s"},
+		{"negative sub-second", -250 * time.Millisecond, "-250ms"},
+		{"max int64", math.MaxInt64, "106751d 23h"},
+		{"min int64", math.MinInt64, "-106751d 23h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := format.Duration(tt.in); got != tt.want {
+				t.Errorf("Duration(%v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want string
+	}{
+		{"just now", now.Add(-20 * time.Second), "just now"},
+		{"minutes ago", now.Add(-5 * time.Minute), "5m ago"},
+		{"hours ago", now.Add(-3*time.Hour - 40*time.Minute), "3h ago"},
+		{"days ago", now.Add(-50 * time.Hour), "2d ago"},
+		{"in the future", now.Add(10 * time.Minute), "in 10m"},
+		{"older than cutoff", now.AddDate(0, -2, 0), "2025-04-15"},
+		{"far future beyond duration range", now.AddDate(500, 0, 0), "2525-06-15"},
+		{"far past beyond duration range", now.AddDate(-500, 0, 0), "1525-06-15"},
+		{"zero time", time.Time{}, "never"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := format.RelativeTime(tt.at, now); got != tt.want {
+				t.Errorf("RelativeTime() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocaleDecimalSeparator(t *testing.T) {
+	tests := []struct {
+		name         string
+		formatter    format.Formatter
+		wantBytes    string
+		wantDuration string
+	}{
+		{"zero value", format.Formatter{}, "1.5 KB", "0.3ms"},
+		{"empty locale", format.New(""), "1.5 KB", "0.3ms"},
+		{"en-US", format.New("en-US"), "1.5 KB", "0.3ms"},
+		{"de-DE", format.New("de-DE"), "1,5 KB", "0,3ms"},
+		{"fr_FR.UTF-8", format.New("fr_FR.UTF-8"), "1,5 KB", "0,3ms"},
+		{"unknown locale", format.New("xx"), "1.5 KB", "0.3ms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.formatter.Bytes(1500); got != tt.wantBytes {
+				t.Errorf("Bytes(1500) = %q, want %q", got, tt.wantBytes)
+			}
+			if got := tt.formatter.Duration(300 * time.Microsecond); got != tt.wantDuration {
+				t.Errorf("Duration(300µs) = %q, want %q", got, tt.wantDuration)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package format
+
+import (
+	"strconv"
+	"time"
+)
+
+// relativeCutoff is how far back RelativeTime uses relative phrasing
+// before switching to an absolute date.
+const relativeCutoff = 30 * day
+
+// RelativeTime formats t relative to now using the default locale.
+func RelativeTime(t, now time.Time) string {
+	return defaultFormatter.RelativeTime(t, now)
+}
+
+// RelativeTime formats t relative to now with its most significant unit:
+// "just now", "3h ago", "in 5m". Timestamps further than 30 days away are
+// shown as a date (2006-01-02) since "47d ago" is harder to read. The zero
+// time renders as "never", for timestamps that were never set.
+func (f Formatter) RelativeTime(t, now time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+
+	// Compare before subtracting: Sub clamps to the Duration range, so
+	// negating a clamped difference would overflow for far-off dates.
+	future := t.After(now)
+	diff := now.Sub(t)
+	if future {
+		diff = t.Sub(now)
+	}
+
+	if diff < time.Minute {
+		return "just now"
+	}
+	if diff >= relativeCutoff {
+		return t.Format(time.DateOnly)
+	}
+
+	value := ""
+	for _, unit := range durationUnits {
+		if diff >= unit.size {
+			value = strconv.FormatInt(int64(diff/unit.size), 10) + unit.suffix
+			break
+		}
+	}
+
+	if future {
+		return "in " + value
+	}
+	return value + " ago"
+}
@@ -0,0 +1,46 @@
+package format
+
+import (
+	"math"
+	"strconv"
+)
+
+// sizeUnit is the SI step between byte size units.
+const sizeUnit = 1000
+
+var sizeSuffixes = []string{"KB", "MB", "GB", "TB", "PB", "EB"}
+
+// Bytes formats n using the default locale, e.g. "1.2 GB".
+func Bytes(n int64) string {
+	return defaultFormatter.Bytes(n)
+}
+
+// Bytes formats n as a decimal (SI) size with one fractional digit, e.g.
+// "512 B", "1.2 GB". Negative sizes keep their sign.
+func (f Formatter) Bytes(n int64) string {
+	sign := ""
+	u := uint64(n)
+	if n < 0 {
+		sign = "-"
+		u = uint64(-(n + 1)) + 1
+	}
+
+	if u < sizeUnit {
+		return sign + strconv.FormatUint(u, 10) + " B"
+	}
+
+	value := float64(u) / sizeUnit
+	exp := 0
+	for value >= sizeUnit && exp < len(sizeSuffixes)-1 {
+		value /= sizeUnit
+		exp++
+	}
+
+	// Rounding can push e.g. 999.95 KB up to "1000 KB"; promote it instead.
+	if math.Round(value*10)/10 >= sizeUnit && exp < len(sizeSuffixes)-1 {
+		value /= sizeUnit
+		exp++
+	}
+
+	return sign + f.decimal(value) + " " + sizeSuffixes[exp]
+}
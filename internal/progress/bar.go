@@ -0,0 +1,123 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// barWidth is the number of cells in an interactive bar.
+const barWidth = 30
+
+// plainStep is the percentage granularity of plain-mode bar output.
+const plainStep = 25
+
+// Bar tracks an operation with a known amount of work.
+type Bar struct {
+	w     io.Writer
+	mode  Mode
+	label string
+	total int64
+
+	mu       sync.Mutex
+	current  int64
+	reported int
+	finished bool
+
+	// drawnFilled and drawnPct describe the last interactive render.
+	drawnFilled int
+	drawnPct    int
+}
+
+// NewBar creates a bar for total units of work. A non-positive total is
+// treated as 1 so the bar can still be finished.
+func NewBar(w io.Writer, label string, total int64, mode Mode) *Bar {
+	if total <= 0 {
+		total = 1
+	}
+
+	return &Bar{
+		w:           w,
+		mode:        resolveMode(w, mode),
+		label:       label,
+		total:       total,
+		drawnFilled: -1,
+		drawnPct:    -1,
+	}
+}
+
+// Add advances the bar by n units.
+func (b *Bar) Add(n int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.set(b.current + n)
+}
+
+// Set moves the bar to n units.
+func (b *Bar) Set(n int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.set(n)
+}
+
+// Finish completes the bar and ends its line. Further updates are ignored.
+func (b *Bar) Finish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.finished {
+		return
+	}
+	b.set(b.total)
+	b.finished = true
+
+	if b.mode == ModeInteractive {
+		fmt.Fprintln(b.w)
+	}
+}
+
+// Percent returns the completed share of work in the range 0-100.
+func (b *Bar) Percent() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.percent()
+}
+
+// set updates the position and renders it. Callers must hold b.mu.
+func (b *Bar) set(n int64) {
+	if b.finished {
+		return
+	}
+
+	b.current = min(max(n, 0), b.total)
+	pct := b.percent()
+
+	if b.mode == ModeInteractive {
+		// Byte-level callers Add once per chunk; only redraw when the
+		// visible output actually changes.
+		filled := int(int64(barWidth) * b.current / b.total)
+		if filled == b.drawnFilled && pct == b.drawnPct {
+			return
+		}
+		b.drawnFilled, b.drawnPct = filled, pct
+
+		fmt.Fprintf(b.w, "%s%s [%s%s] %3d%%", clearLine, b.label,
+			strings.Repeat("=", filled), strings.Repeat(" ", barWidth-filled), pct)
+		return
+	}
+
+	// Plain output only reports crossed milestones to keep logs short.
+	step := pct / plainStep * plainStep
+	if step > b.reported {
+		b.reported = step
+		fmt.Fprintf(b.w, "%s: %d%%\n", b.label, step)
+	}
+}
+
+func (b *Bar) percent() int {
+	return int(b.current * 100 / b.total)
+}
@@ -0,0 +1,18 @@
+package progress
+
+import (
+	"io"
+	"testing"
+)
+
+// ResolveMode exposes resolveMode to the black-box tests.
+var ResolveMode = resolveMode
+
+// StubTerminal makes terminal detection report tty for the rest of the test.
+func StubTerminal(tb testing.TB, tty bool) {
+	tb.Helper()
+
+	orig := isTerminal
+	isTerminal = func(io.Writer) bool { return tty }
+	tb.Cleanup(func() { isTerminal = orig })
+}
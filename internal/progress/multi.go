@@ -0,0 +1,161 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// TaskState is the lifecycle state of a task in a Multi tracker.
+type TaskState int
+
+const (
+	// TaskPending means the task has not started yet.
+	TaskPending TaskState = iota
+	// TaskRunning means the task is in progress.
+	TaskRunning
+	// TaskDone means the task completed successfully.
+	TaskDone
+	// TaskFailed means the task completed with an error.
+	TaskFailed
+)
+
+// String returns the name of the state.
+func (s TaskState) String() string {
+	switch s {
+	case TaskPending:
+		return "pending"
+	case TaskRunning:
+		return "running"
+	case TaskDone:
+		return "done"
+	case TaskFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// marker returns the glyph shown for the state in interactive mode.
+func (s TaskState) marker() string {
+	switch s {
+	case TaskPending:
+		return "·"
+	case TaskRunning:
+		return "…"
+	case TaskDone:
+		return "✓"
+	case TaskFailed:
+		return "✗"
+	default:
+		return "?"
+	}
+}
+
+// Multi tracks several concurrent tasks, one line per task.
+type Multi struct {
+	w    io.Writer
+	mode Mode
+
+	mu    sync.Mutex
+	tasks []*Task
+	drawn int
+}
+
+// Task is a single entry in a Multi tracker.
+type Task struct {
+	multi *Multi
+	name  string
+	state TaskState
+	err   error
+}
+
+// NewMulti creates an empty multi-task tracker writing to w.
+func NewMulti(w io.Writer, mode Mode) *Multi {
+	return &Multi{
+		w:    w,
+		mode: resolveMode(w, mode),
+	}
+}
+
+// Add registers a new pending task.
+func (m *Multi) Add(name string) *Task {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	task := &Task{multi: m, name: name}
+	m.tasks = append(m.tasks, task)
+	m.redraw()
+
+	return task
+}
+
+// Start marks the task as running.
+func (t *Task) Start() {
+	t.update(TaskRunning, nil)
+}
+
+// Done marks the task as finished. A non-nil err marks it as failed.
+func (t *Task) Done(err error) {
+	if err != nil {
+		t.update(TaskFailed, err)
+		return
+	}
+	t.update(TaskDone, nil)
+}
+
+// State returns the current state of the task.
+func (t *Task) State() TaskState {
+	t.multi.mu.Lock()
+	defer t.multi.mu.Unlock()
+
+	return t.state
+}
+
+func (t *Task) update(state TaskState, err error) {
+	m := t.multi
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t.state = state
+	t.err = err
+
+	if m.mode == ModeInteractive {
+		m.redraw()
+		return
+	}
+
+	// Plain output skips the pending state and reports transitions only.
+	fmt.Fprintln(m.w, t.plainLine())
+}
+
+// redraw repaints every task line in place. Callers must hold m.mu.
+func (m *Multi) redraw() {
+	if m.mode != ModeInteractive {
+		return
+	}
+
+	if m.drawn > 0 {
+		fmt.Fprintf(m.w, "\r"+cursorUp, m.drawn)
+	}
+	for _, task := range m.tasks {
+		fmt.Fprintf(m.w, "%s%s\n", clearLine, task.interactiveLine())
+	}
+	m.drawn = len(m.tasks)
+}
+
+// interactiveLine renders the task as "<marker> <name>[: err]".
+func (t *Task) interactiveLine() string {
+	if t.err != nil {
+		return fmt.Sprintf("%s %s: %v", t.state.marker(), t.name, t.err)
+	}
+	return fmt.Sprintf("%s %s", t.state.marker(), t.name)
+}
+
+// plainLine renders the task as "<name>: <state>[: err]" using ASCII only.
+func (t *Task) plainLine() string {
+	if t.err != nil {
+		return fmt.Sprintf("%s: %s: %v", t.name, t.state, t.err)
+	}
+	return fmt.Sprintf("%s: %s", t.name, t.state)
+}
@@ -0,0 +1,60 @@
+// Package progress renders feedback for long-running operations.
+//
+// Spinners, bars and multi-task trackers redraw in place when writing to a
+// terminal and fall back to plain, line-oriented output otherwise (pipes,
+// CI logs, redirected files), so the same call sites work in both cases.
+package progress
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// Mode selects how progress is rendered.
+type Mode int
+
+const (
+	// ModeAuto picks ModeInteractive for terminals and ModePlain otherwise.
+	ModeAuto Mode = iota
+	// ModePlain writes one line per state change and never redraws.
+	ModePlain
+	// ModeInteractive redraws in place using carriage returns and ANSI codes.
+	ModeInteractive
+)
+
+// ANSI control sequences used by interactive rendering.
+const (
+	clearLine = "\r\033[2K"
+	cursorUp  = "\033[%dA"
+)
+
+// IsTerminal reports whether w is an interactive terminal.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	return term.IsTerminal(int(f.Fd())) //nolint:gosec // file descriptors fit in int
+}
+
+// isTerminal is swapped out by tests that need a fake terminal.
+var isTerminal = IsTerminal
+
+// resolveMode turns ModeAuto, and any unknown value, into a concrete mode
+// for w. Terminals that declare TERM=dumb cannot handle ANSI redraws and
+// get plain output.
+func resolveMode(w io.Writer, mode Mode) Mode {
+	switch mode {
+	case ModePlain, ModeInteractive:
+		return mode
+	case ModeAuto:
+	}
+
+	if isTerminal(w) && os.Getenv("TERM") != "dumb" {
+		return ModeInteractive
+	}
+	return ModePlain
+}
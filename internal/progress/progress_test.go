@@ -0,0 +1,239 @@
+package progress_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/LeafLock-Security-Solutions/lazispace/internal/progress"
+)
+
+func TestIsTerminal(t *testing.T) {
+	t.Run("buffer", func(t *testing.T) {
+		if progress.IsTerminal(&bytes.Buffer{}) {
+			t.Error("expected buffer not to be a terminal")
+		}
+	})
+
+	t.Run("regular file", func(t *testing.T) {
+		f, err := os.CreateTemp(t.TempDir(), "progress")
+		if err != nil {
+			t.Fatalf("failed to create temp file: %v", err)
+		}
+		defer f.Close()
+
+		if progress.IsTerminal(f) {
+			t.Error("expected regular file not to be a terminal")
+		}
+	})
+}
+
+func TestResolveMode(t *testing.T) {
+	tests := []struct {
+		name string
+		tty  bool
+		term string
+		mode progress.Mode
+		want progress.Mode
+	}{
+		{"auto on terminal", true, "xterm-256color", progress.ModeAuto, progress.ModeInteractive},
+		{"auto on dumb terminal", true, "dumb", progress.ModeAuto, progress.ModePlain},
+		{"auto without terminal", false, "xterm-256color", progress.ModeAuto, progress.ModePlain},
+		{"explicit plain on terminal", true, "xterm-256color", progress.ModePlain, progress.ModePlain},
+		{"explicit interactive on dumb terminal", true, "dumb", progress.ModeInteractive, progress.ModeInteractive},
+		{"unknown mode on terminal", true, "xterm-256color", progress.Mode(42), progress.ModeInteractive},
+		{"unknown mode without terminal", false, "xterm-256color", progress.Mode(42), progress.ModePlain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress.StubTerminal(t, tt.tty)
+			t.Setenv("TERM", tt.term)
+
+			if got := progress.ResolveMode(&bytes.Buffer{}, tt.mode); got != tt.want {
+				t.Errorf("expected mode %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSpinnerUnknownMode(t *testing.T) {
+	var buf bytes.Buffer
+
+	// An out-of-range mode must not start a redraw goroutine that Stop
+	// never shuts down.
+	s := progress.NewSpinner(&buf, "checking", progress.Mode(3))
+	s.Start()
+	s.Stop("ok")
+
+	if want := "checking...\nok\n"; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestTaskStateString(t *testing.T) {
+	tests := []struct {
+		state progress.TaskState
+		want  string
+	}{
+		{progress.TaskPending, "pending"},
+		{progress.TaskRunning, "running"},
+		{progress.TaskDone, "done"},
+		{progress.TaskFailed, "failed"},
+		{progress.TaskState(42), "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.state.String(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSpinnerPlain(t *testing.T) {
+	var buf bytes.Buffer
+
+	s := progress.NewSpinner(&buf, "scanning", progress.ModeAuto)
+	s.Start()
+	s.SetMessage("indexing")
+	s.Stop("scan complete")
+
+	want := "scanning...\nindexing...\nscan complete\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestSpinnerInteractive(t *testing.T) {
+	var buf bytes.Buffer
+
+	s := progress.NewSpinner(&buf, "syncing", progress.ModeInteractive)
+	s.Start()
+	s.Stop("done")
+
+	out := buf.String()
+	if !strings.Contains(out, "syncing") {
+		t.Errorf("expected spinner message in output, got %q", out)
+	}
+	if !strings.HasSuffix(out, "done\n") {
+		t.Errorf("expected final message at end, got %q", out)
+	}
+}
+
+func TestBarPlain(t *testing.T) {
+	var buf bytes.Buffer
+
+	b := progress.NewBar(&buf, "backup", 8, progress.ModePlain)
+	for i := 0; i < 8; i++ {
+		b.Add(1)
+	}
+	b.Finish()
+
+	want := "backup: 25%\nbackup: 50%\nbackup: 75%\nbackup: 100%\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestBarPercent(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int64
+		set   int64
+		want  int
+	}{
+		{"empty", 10, 0, 0},
+		{"half", 10, 5, 50},
+		{"clamped above", 10, 20, 100},
+		{"clamped below", 10, -3, 0},
+		{"zero total", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := progress.NewBar(&bytes.Buffer{}, "x", tt.total, progress.ModePlain)
+			b.Set(tt.set)
+
+			if got := b.Percent(); got != tt.want {
+				t.Errorf("expected %d%%, got %d%%", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBarInteractive(t *testing.T) {
+	var buf bytes.Buffer
+
+	b := progress.NewBar(&buf, "update", 2, progress.ModeInteractive)
+	b.Add(1)
+	b.Finish()
+	b.Add(1) // ignored after Finish
+
+	out := buf.String()
+	if !strings.Contains(out, " 50%") || !strings.Contains(out, "100%") {
+		t.Errorf("expected 50%% and 100%% renders, got %q", out)
+	}
+	if strings.Count(out, "\n") != 1 {
+		t.Errorf("expected a single trailing newline, got %q", out)
+	}
+}
+
+func TestBarInteractiveRedrawsOnlyOnChange(t *testing.T) {
+	var buf bytes.Buffer
+
+	b := progress.NewBar(&buf, "download", 1_000_000, progress.ModeInteractive)
+	for i := 0; i < 1_000_000; i += 100 {
+		b.Add(100)
+	}
+
+	// 10k Add calls, but the output only changes when the percentage
+	// (100 steps) or the filled cell count (30 steps) moves.
+	if got := strings.Count(buf.String(), "download ["); got > 131 {
+		t.Errorf("expected at most 131 redraws, got %d", got)
+	}
+}
+
+func TestMultiPlain(t *testing.T) {
+	var buf bytes.Buffer
+
+	m := progress.NewMulti(&buf, progress.ModePlain)
+	api := m.Add("api")
+	web := m.Add("web")
+
+	api.Start()
+	web.Start()
+	api.Done(nil)
+	web.Done(errors.New("port in use"))
+
+	want := "api: running\nweb: running\napi: done\nweb: failed: port in use\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+
+	if api.State() != progress.TaskDone {
+		t.Errorf("expected api to be done, got %v", api.State())
+	}
+	if web.State() != progress.TaskFailed {
+		t.Errorf("expected web to be failed, got %v", web.State())
+	}
+}
+
+func TestMultiInteractive(t *testing.T) {
+	var buf bytes.Buffer
+
+	m := progress.NewMulti(&buf, progress.ModeInteractive)
+	task := m.Add("db")
+	task.Done(nil)
+
+	out := buf.String()
+	if !strings.Contains(out, "\033[1A") {
+		t.Errorf("expected cursor to move up on redraw, got %q", out)
+	}
+	if !strings.HasSuffix(out, "✓ db\n") {
+		t.Errorf("expected final render of task, got %q", out)
+	}
+}
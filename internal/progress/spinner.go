@@ -0,0 +1,119 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// spinnerInterval is the delay between spinner frames.
+const spinnerInterval = 100 * time.Millisecond
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Spinner shows that an operation of unknown length is still running.
+type Spinner struct {
+	w       io.Writer
+	mode    Mode
+	message string
+
+	mu      sync.Mutex
+	frame   int
+	running bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewSpinner creates a spinner writing to w. It does nothing until Start.
+func NewSpinner(w io.Writer, message string, mode Mode) *Spinner {
+	return &Spinner{
+		w:       w,
+		mode:    resolveMode(w, mode),
+		message: message,
+	}
+}
+
+// Start begins rendering the spinner. Calling Start twice has no effect.
+func (s *Spinner) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return
+	}
+	s.running = true
+
+	if s.mode != ModeInteractive {
+		s.printPlain()
+		return
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	s.render()
+
+	go s.loop()
+}
+
+// SetMessage replaces the text shown next to the spinner.
+func (s *Spinner) SetMessage(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.message = message
+	if s.running && s.mode == ModePlain {
+		s.printPlain()
+	}
+}
+
+// Stop halts the spinner and prints final in its place, if not empty.
+func (s *Spinner) Stop(final string) {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = false
+	stop, done := s.stop, s.done
+	s.mu.Unlock()
+
+	if s.mode == ModeInteractive {
+		close(stop)
+		<-done
+		fmt.Fprint(s.w, clearLine)
+	}
+
+	if final != "" {
+		fmt.Fprintln(s.w, final)
+	}
+}
+
+func (s *Spinner) loop() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.frame = (s.frame + 1) % len(spinnerFrames)
+			s.render()
+			s.mu.Unlock()
+		}
+	}
+}
+
+// printPlain writes the message as a single line. Callers must hold s.mu.
+func (s *Spinner) printPlain() {
+	fmt.Fprintf(s.w, "%s...\n", s.message)
+}
+
+// render draws the current frame. Callers must hold s.mu.
+func (s *Spinner) render() {
+	fmt.Fprintf(s.w, "%s%s %s", clearLine, spinnerFrames[s.frame], s.message)
+}
@@ -0,0 +1,111 @@
+package shellenv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultMaxAge is how long a cached environment is reused.
+const DefaultMaxAge = 24 * time.Hour
+
+// ErrCacheWrite is returned together with a valid Env when the capture
+// succeeded but the cache file could not be written.
+var ErrCacheWrite = errors.New("failed to write shell env cache")
+
+// Options configures Load.
+type Options struct {
+	// Shell is the login shell to run. Empty means DefaultShell.
+	Shell string
+	// CachePath is the JSON file the environment is cached in.
+	// Empty disables caching.
+	CachePath string
+	// MaxAge is how long a cached environment stays valid.
+	// Zero means DefaultMaxAge.
+	MaxAge time.Duration
+	// Timeout bounds the login shell. Zero means DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Load returns the cached environment when it is fresh and was captured
+// from the same shell, and otherwise captures a new one and caches it.
+//
+// Caching is best-effort. When only the cache write fails, Load returns
+// the captured Env together with an error wrapping ErrCacheWrite, so
+// callers can keep using the Env and report the cache problem.
+func Load(ctx context.Context, opts Options) (*Env, error) {
+	if opts.Shell == "" {
+		opts.Shell = DefaultShell()
+	}
+	if opts.MaxAge <= 0 {
+		opts.MaxAge = DefaultMaxAge
+	}
+
+	if opts.CachePath != "" {
+		if env, err := readCache(opts.CachePath); err == nil &&
+			env.Shell == opts.Shell && time.Since(env.CapturedAt) < opts.MaxAge {
+			return env, nil
+		}
+	}
+
+	env, err := Capture(ctx, opts.Shell, opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.CachePath != "" {
+		if err := writeCache(opts.CachePath, env); err != nil {
+			return env, fmt.Errorf("%w: %w", ErrCacheWrite, err)
+		}
+	}
+
+	return env, nil
+}
+
+func readCache(path string) (*Env, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var env Env
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+
+	return &env, nil
+}
+
+// writeCache stores env atomically. The file is private to the user since
+// the environment may contain tokens.
+func writeCache(path string, env *Env) error {
+	data, err := json.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".shellenv-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck,gosec // write error takes precedence
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
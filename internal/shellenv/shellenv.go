@@ -0,0 +1,156 @@
+// Package shellenv captures the user's login-shell environment.
+//
+// Processes started from GUI contexts (Finder/Spotlight, desktop launchers)
+// inherit a minimal environment without the PATH entries and version
+// manager shims configured in shell rc files. Capturing the environment of
+// a login shell once, and reusing it, lets launched editors and services
+// behave the same as when lazispace runs from a terminal.
+package shellenv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout bounds how long a login shell may take to start.
+const DefaultTimeout = 5 * time.Second
+
+// waitDelay bounds how long to wait for output pipes after the shell is
+// killed, since children spawned by rc files may keep them open.
+const waitDelay = 500 * time.Millisecond
+
+// marker separates anything printed by rc files from the env dump.
+const marker = "__LAZISPACE_ENV_BEGIN__"
+
+// captureScript prints the marker followed by the NUL-separated environment.
+const captureScript = "printf '%s\\0' " + marker + "; env -0"
+
+// volatileKeys describe the capturing shell itself rather than the user's
+// setup and must not leak into launched processes.
+var volatileKeys = map[string]bool{
+	"_":      true,
+	"OLDPWD": true,
+	"PWD":    true,
+	"SHLVL":  true,
+}
+
+var (
+	// ErrUnsupported is returned on platforms without POSIX login shells.
+	ErrUnsupported = errors.New("login shell capture is not supported on this platform")
+	// ErrTimeout is returned when the login shell does not finish in time.
+	ErrTimeout = errors.New("login shell timed out")
+	// ErrNoOutput is returned when the shell output lacks the env dump.
+	ErrNoOutput = errors.New("login shell produced no environment")
+	// ErrUnsupportedShell is returned for shells that cannot run a command
+	// as a login shell.
+	ErrUnsupportedShell = errors.New("shell cannot run a command as a login shell")
+)
+
+// unsupportedShells only accept -l as their sole flag, so `-l -c` fails.
+var unsupportedShells = map[string]bool{
+	"csh":  true,
+	"tcsh": true,
+}
+
+// Env is a captured environment.
+type Env struct {
+	Shell      string            `json:"shell"`
+	CapturedAt time.Time         `json:"capturedAt"`
+	Vars       map[string]string `json:"vars"`
+}
+
+// DefaultShell returns $SHELL, or /bin/sh when it is unset.
+func DefaultShell() string {
+	if shell := os.Getenv("SHELL"); shell != "" {
+		return shell
+	}
+	return "/bin/sh"
+}
+
+// Capture runs shell as a login shell (`shell -l -c ...`) and returns its
+// environment. An empty shell means DefaultShell. A timeout of zero means
+// DefaultTimeout.
+//
+// csh and tcsh only accept -l as their sole flag, so they cannot be
+// captured and return ErrUnsupportedShell. Callers can retry with a
+// POSIX shell such as /bin/sh.
+func Capture(ctx context.Context, shell string, timeout time.Duration) (*Env, error) {
+	if runtime.GOOS == "windows" {
+		return nil, ErrUnsupported
+	}
+	if shell == "" {
+		shell = DefaultShell()
+	}
+	if unsupportedShells[filepath.Base(shell)] {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedShell, shell)
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, shell, "-l", "-c", captureScript) //nolint:gosec // runs the user's own login shell with a fixed script
+	cmd.Stdout = &stdout
+	cmd.WaitDelay = waitDelay
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("%w after %s: %s", ErrTimeout, timeout, shell)
+		}
+		return nil, fmt.Errorf("failed to run login shell %s: %w", shell, err)
+	}
+
+	vars, err := parse(stdout.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", shell, err)
+	}
+
+	return &Env{
+		Shell:      shell,
+		CapturedAt: time.Now(),
+		Vars:       vars,
+	}, nil
+}
+
+// parse extracts variables from the NUL-separated output after the marker.
+func parse(out []byte) (map[string]string, error) {
+	_, dump, found := bytes.Cut(out, []byte(marker+"\x00"))
+	if !found {
+		return nil, ErrNoOutput
+	}
+
+	vars := make(map[string]string)
+	for _, entry := range bytes.Split(dump, []byte{0}) {
+		key, value, ok := strings.Cut(string(entry), "=")
+		if !ok || key == "" || volatileKeys[key] {
+			continue
+		}
+		vars[key] = value
+	}
+
+	return vars, nil
+}
+
+// Environ returns the variables as sorted KEY=VALUE pairs, ready for
+// exec.Cmd.Env.
+func (e *Env) Environ() []string {
+	environ := make([]string, 0, len(e.Vars))
+	for key, value := range e.Vars {
+		environ = append(environ, key+"="+value)
+	}
+	sort.Strings(environ)
+
+	return environ
+}
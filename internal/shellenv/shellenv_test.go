@@ -0,0 +1,187 @@
+package shellenv_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/LeafLock-Security-Solutions/lazispace/internal/shellenv"
+)
+
+// fakeShell writes an executable that mimics a login shell: it prints rc
+// file noise, exports extra variables and then runs the capture script.
+func fakeShell(t *testing.T, body string) string {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("login shell capture is not supported on windows")
+	}
+
+	path := filepath.Join(t.TempDir(), "fake-shell")
+	script := "#!/bin/sh\n" + body + "\nexec /bin/sh -c \"$3\"\n"
+	if err := os.WriteFile(path, []byte(script), 0o700); err != nil {
+		t.Fatalf("failed to write fake shell: %v", err)
+	}
+
+	return path
+}
+
+func TestCapture(t *testing.T) {
+	shell := fakeShell(t, `echo "Welcome to your login shell"
+export PATH="/opt/shims:$PATH"
+export LSPACE_TEST_VAR='has spaces
+and a newline'`)
+
+	env, err := shellenv.Capture(context.Background(), shell, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if env.Shell != shell {
+		t.Errorf("expected shell %q, got %q", shell, env.Shell)
+	}
+	if got := env.Vars["LSPACE_TEST_VAR"]; got != "has spaces\nand a newline" {
+		t.Errorf("unexpected LSPACE_TEST_VAR %q", got)
+	}
+	if got := env.Vars["PATH"]; len(got) < 10 || got[:10] != "/opt/shims" {
+		t.Errorf("expected PATH to start with /opt/shims, got %q", got)
+	}
+
+	t.Run("volatile keys dropped", func(t *testing.T) {
+		for _, key := range []string{"PWD", "SHLVL", "_"} {
+			if _, ok := env.Vars[key]; ok {
+				t.Errorf("expected %s to be dropped", key)
+			}
+		}
+	})
+
+	t.Run("environ sorted", func(t *testing.T) {
+		environ := env.Environ()
+		if !slices.IsSorted(environ) {
+			t.Error("expected Environ to be sorted")
+		}
+		if !slices.Contains(environ, "LSPACE_TEST_VAR=has spaces\nand a newline") {
+			t.Error("expected Environ to contain LSPACE_TEST_VAR")
+		}
+	})
+}
+
+func TestCaptureErrors(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		shell := fakeShell(t, "sleep 5")
+
+		_, err := shellenv.Capture(context.Background(), shell, 100*time.Millisecond)
+		if !errors.Is(err, shellenv.ErrTimeout) {
+			t.Errorf("expected ErrTimeout, got %v", err)
+		}
+	})
+
+	t.Run("no env output", func(t *testing.T) {
+		shell := fakeShell(t, "echo nothing useful; exit 0")
+
+		_, err := shellenv.Capture(context.Background(), shell, time.Second)
+		if !errors.Is(err, shellenv.ErrNoOutput) {
+			t.Errorf("expected ErrNoOutput, got %v", err)
+		}
+	})
+
+	t.Run("csh family rejected", func(t *testing.T) {
+		for _, shell := range []string{"/bin/csh", "/usr/local/bin/tcsh"} {
+			_, err := shellenv.Capture(context.Background(), shell, time.Second)
+			if !errors.Is(err, shellenv.ErrUnsupportedShell) {
+				t.Errorf("%s: expected ErrUnsupportedShell, got %v", shell, err)
+			}
+		}
+	})
+
+	t.Run("shell fails", func(t *testing.T) {
+		shell := fakeShell(t, "exit 3")
+
+		if _, err := shellenv.Capture(context.Background(), shell, time.Second); err == nil {
+			t.Error("expected error for failing shell")
+		}
+	})
+}
+
+func TestLoadCache(t *testing.T) {
+	shell := fakeShell(t, "export LSPACE_TEST_VAR=first")
+	cachePath := filepath.Join(t.TempDir(), "cache", "shellenv.json")
+	opts := shellenv.Options{Shell: shell, CachePath: cachePath, Timeout: time.Second}
+
+	first, err := shellenv.Load(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	info, err := os.Stat(cachePath)
+	if err != nil {
+		t.Fatalf("expected cache file: %v", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0o600 {
+		t.Errorf("expected cache mode 0600, got %o", info.Mode().Perm())
+	}
+
+	// Make a fresh capture distinguishable from the cached one.
+	if err := os.WriteFile(shell, []byte("#!/bin/sh\nexport LSPACE_TEST_VAR=second\nexec /bin/sh -c \"$3\"\n"), 0o700); err != nil {
+		t.Fatalf("failed to rewrite fake shell: %v", err)
+	}
+
+	t.Run("fresh cache reused", func(t *testing.T) {
+		env, err := shellenv.Load(context.Background(), opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := env.Vars["LSPACE_TEST_VAR"]; got != "first" {
+			t.Errorf("expected cached value %q, got %q", "first", got)
+		}
+		if !env.CapturedAt.Equal(first.CapturedAt) {
+			t.Error("expected cached capture time")
+		}
+	})
+
+	t.Run("expired cache recaptured", func(t *testing.T) {
+		expired := opts
+		expired.MaxAge = time.Nanosecond
+
+		env, err := shellenv.Load(context.Background(), expired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := env.Vars["LSPACE_TEST_VAR"]; got != "second" {
+			t.Errorf("expected recaptured value %q, got %q", "second", got)
+		}
+	})
+}
+
+func TestLoadUnwritableCache(t *testing.T) {
+	shell := fakeShell(t, "export LSPACE_TEST_VAR=captured")
+
+	// A regular file where the cache directory should be makes the write
+	// fail regardless of the user running the tests.
+	blocker := filepath.Join(t.TempDir(), "not-a-dir")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatalf("failed to create blocker file: %v", err)
+	}
+
+	opts := shellenv.Options{
+		Shell:     shell,
+		CachePath: filepath.Join(blocker, "shellenv.json"),
+		Timeout:   time.Second,
+	}
+
+	env, err := shellenv.Load(context.Background(), opts)
+	if !errors.Is(err, shellenv.ErrCacheWrite) {
+		t.Fatalf("expected ErrCacheWrite, got %v", err)
+	}
+	if env == nil {
+		t.Fatal("expected captured env despite cache write failure")
+	}
+	if got := env.Vars["LSPACE_TEST_VAR"]; got != "captured" {
+		t.Errorf("expected %q, got %q", "captured", got)
+	}
+}
@@ -0,0 +1,4 @@
+// Package testutil provides shared helpers for LaziSpace tests.
+//
+// It is only meant to be imported from *_test.go files.
+package testutil
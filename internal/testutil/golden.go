@@ -0,0 +1,49 @@
+package testutil
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// UpdateGoldenEnv is the environment variable that makes AssertGolden
+// rewrite golden files with the current output:
+//
+//	UPDATE_GOLDEN=1 go test ./...
+//
+// An environment variable is used instead of a flag so importing this
+// package never clashes with flags declared by the test packages.
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// GoldenPath returns the path of the golden file for name inside testdata.
+func GoldenPath(name string) string {
+	return filepath.Join("testdata", name+".golden")
+}
+
+// AssertGolden compares got against testdata/<name>.golden.
+// When UPDATE_GOLDEN=1 is set the golden file is (re)written instead.
+func AssertGolden(tb testing.TB, name string, got []byte) {
+	tb.Helper()
+
+	path := filepath.Clean(GoldenPath(name))
+
+	if os.Getenv(UpdateGoldenEnv) == "1" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			tb.Fatalf("failed to create golden dir: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			tb.Fatalf("failed to update golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("failed to read golden file %s (set %s=1 to create it): %v", path, UpdateGoldenEnv, err)
+	}
+
+	if !bytes.Equal(got, want) {
+		tb.Errorf("output does not match %s\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
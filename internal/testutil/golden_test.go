@@ -0,0 +1,112 @@
+package testutil_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/LeafLock-Security-Solutions/lazispace/internal/testutil"
+)
+
+// recordingTB captures failures reported through testing.TB instead of
+// failing the surrounding test.
+type recordingTB struct {
+	testing.TB
+	failed bool
+	fatal  bool
+	msg    string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.failed = true
+	r.msg = fmt.Sprintf(format, args...)
+}
+
+func (r *recordingTB) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+	runtime.Goexit()
+}
+
+// runRecorded runs fn against a recordingTB in its own goroutine so that
+// Fatalf can stop it the same way the testing package does.
+func runRecorded(t *testing.T, fn func(tb testing.TB)) *recordingTB {
+	t.Helper()
+
+	rec := &recordingTB{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(rec)
+	}()
+	<-done
+
+	return rec
+}
+
+func TestGoldenPath(t *testing.T) {
+	got := testutil.GoldenPath("sample")
+	want := filepath.Join("testdata", "sample.golden")
+
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		rec := runRecorded(t, func(tb testing.TB) {
+			testutil.AssertGolden(tb, "sample", []byte("hello golden\n"))
+		})
+
+		if rec.failed {
+			t.Errorf("unexpected failure: %s", rec.msg)
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		rec := runRecorded(t, func(tb testing.TB) {
+			testutil.AssertGolden(tb, "sample", []byte("something else\n"))
+		})
+
+		if !rec.failed || rec.fatal {
+			t.Fatalf("expected a non-fatal failure, got failed=%v fatal=%v", rec.failed, rec.fatal)
+		}
+		if !strings.Contains(rec.msg, "does not match") {
+			t.Errorf("unexpected failure message: %s", rec.msg)
+		}
+	})
+
+	t.Run("missing golden file", func(t *testing.T) {
+		rec := runRecorded(t, func(tb testing.TB) {
+			testutil.AssertGolden(tb, "does-not-exist", []byte("x"))
+		})
+
+		if !rec.fatal {
+			t.Fatal("expected a fatal failure for a missing golden file")
+		}
+		if !strings.Contains(rec.msg, testutil.UpdateGoldenEnv) {
+			t.Errorf("expected hint about %s, got: %s", testutil.UpdateGoldenEnv, rec.msg)
+		}
+	})
+}
+
+func TestAssertGoldenUpdate(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv(testutil.UpdateGoldenEnv, "1")
+
+	testutil.AssertGolden(t, "generated", []byte("fresh output\n"))
+
+	data, err := os.ReadFile(testutil.GoldenPath("generated"))
+	if err != nil {
+		t.Fatalf("expected golden file to be written: %v", err)
+	}
+	if string(data) != "fresh output\n" {
+		t.Errorf("expected %q, got %q", "fresh output\n", string(data))
+	}
+}
@@ -0,0 +1,56 @@
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// XDGDirs holds the temporary base directories installed by SetupXDG.
+type XDGDirs struct {
+	Home   string
+	Config string
+	Data   string
+	State  string
+	Cache  string
+}
+
+// SetupXDG points the user directory variables at fresh temporary
+// directories: HOME and the XDG base directories, plus USERPROFILE,
+// APPDATA and LOCALAPPDATA for Windows. Code that resolves its paths from
+// these variables never touches the real user directories. On macOS,
+// paths under ~/Library follow the temporary HOME.
+//
+// The environment and the directories are restored/removed automatically
+// when the test finishes. Tests using it must not call t.Parallel.
+func SetupXDG(tb testing.TB) XDGDirs {
+	tb.Helper()
+
+	root := tb.TempDir()
+	dirs := XDGDirs{
+		Home:   filepath.Join(root, "home"),
+		Config: filepath.Join(root, "config"),
+		Data:   filepath.Join(root, "data"),
+		State:  filepath.Join(root, "state"),
+		Cache:  filepath.Join(root, "cache"),
+	}
+
+	for _, dir := range []string{dirs.Home, dirs.Config, dirs.Data, dirs.State, dirs.Cache} {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			tb.Fatalf("failed to create temp dir %s: %v", dir, err)
+		}
+	}
+
+	tb.Setenv("HOME", dirs.Home)
+	tb.Setenv("XDG_CONFIG_HOME", dirs.Config)
+	tb.Setenv("XDG_DATA_HOME", dirs.Data)
+	tb.Setenv("XDG_STATE_HOME", dirs.State)
+	tb.Setenv("XDG_CACHE_HOME", dirs.Cache)
+
+	// Windows: %APPDATA% holds config, %LOCALAPPDATA% holds data and logs.
+	tb.Setenv("USERPROFILE", dirs.Home)
+	tb.Setenv("APPDATA", dirs.Config)
+	tb.Setenv("LOCALAPPDATA", dirs.Data)
+
+	return dirs
+}
@@ -0,0 +1,42 @@
+package testutil_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/LeafLock-Security-Solutions/lazispace/internal/testutil"
+)
+
+func TestSetupXDG(t *testing.T) {
+	dirs := testutil.SetupXDG(t)
+
+	tests := []struct {
+		env  string
+		want string
+	}{
+		{"HOME", dirs.Home},
+		{"XDG_CONFIG_HOME", dirs.Config},
+		{"XDG_DATA_HOME", dirs.Data},
+		{"XDG_STATE_HOME", dirs.State},
+		{"XDG_CACHE_HOME", dirs.Cache},
+		{"USERPROFILE", dirs.Home},
+		{"APPDATA", dirs.Config},
+		{"LOCALAPPDATA", dirs.Data},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			if got := os.Getenv(tt.env); got != tt.want {
+				t.Errorf("expected %s=%q, got %q", tt.env, tt.want, got)
+			}
+
+			info, err := os.Stat(tt.want)
+			if err != nil {
+				t.Fatalf("expected directory to exist: %v", err)
+			}
+			if !info.IsDir() {
+				t.Errorf("expected %s to be a directory", tt.want)
+			}
+		})
+	}
+}